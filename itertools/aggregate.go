@@ -0,0 +1,231 @@
+package itertools
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/thiomajid/go-utils/sets"
+)
+
+// Ordered is satisfied by any type whose values can be compared with < and >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~string
+}
+
+// Number is satisfied by any type whose values can be added and multiplied.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Min returns the smallest element held in the slice. It returns an error if the slice
+// is empty.
+func Min[T Ordered](iterable []T) (T, error) {
+	var zero T
+	if len(iterable) == 0 {
+		return zero, fmt.Errorf("cannot find the minimum of an empty slice")
+	}
+
+	min := iterable[0]
+	for _, elt := range iterable[1:] {
+		if elt < min {
+			min = elt
+		}
+	}
+
+	return min, nil
+}
+
+// Max returns the largest element held in the slice. It returns an error if the slice
+// is empty.
+func Max[T Ordered](iterable []T) (T, error) {
+	var zero T
+	if len(iterable) == 0 {
+		return zero, fmt.Errorf("cannot find the maximum of an empty slice")
+	}
+
+	max := iterable[0]
+	for _, elt := range iterable[1:] {
+		if elt > max {
+			max = elt
+		}
+	}
+
+	return max, nil
+}
+
+// MinBy returns the smallest element held in the slice according to the less function.
+// It returns an error if the slice is empty.
+func MinBy[T any](iterable []T, less func(a, b T) bool) (T, error) {
+	var zero T
+	if len(iterable) == 0 {
+		return zero, fmt.Errorf("cannot find the minimum of an empty slice")
+	}
+
+	min := iterable[0]
+	for _, elt := range iterable[1:] {
+		if less(elt, min) {
+			min = elt
+		}
+	}
+
+	return min, nil
+}
+
+// MaxBy returns the largest element held in the slice according to the less function.
+// It returns an error if the slice is empty.
+func MaxBy[T any](iterable []T, less func(a, b T) bool) (T, error) {
+	var zero T
+	if len(iterable) == 0 {
+		return zero, fmt.Errorf("cannot find the maximum of an empty slice")
+	}
+
+	max := iterable[0]
+	for _, elt := range iterable[1:] {
+		if less(max, elt) {
+			max = elt
+		}
+	}
+
+	return max, nil
+}
+
+// Sum adds up every element held in the slice.
+func Sum[T Number](iterable []T) T {
+	var sum T
+	for _, elt := range iterable {
+		sum += elt
+	}
+
+	return sum
+}
+
+// Product multiplies together every element held in the slice. It returns 0 for an
+// empty slice.
+func Product[T Number](iterable []T) T {
+	if len(iterable) == 0 {
+		var zero T
+		return zero
+	}
+
+	product := iterable[0]
+	for _, elt := range iterable[1:] {
+		product *= elt
+	}
+
+	return product
+}
+
+// Reduce threads each element of the slice through fn along with the accumulator
+// started at initial, and returns the final accumulated value.
+func Reduce[T, U any](iterable []T, initial U, fn func(U, T) U) U {
+	return FoldLeft(iterable, initial, fn)
+}
+
+// FoldLeft threads each element of the slice, from first to last, through fn along
+// with the accumulator started at initial, and returns the final accumulated value.
+func FoldLeft[T, U any](iterable []T, initial U, fn func(U, T) U) U {
+	acc := initial
+	for _, elt := range iterable {
+		acc = fn(acc, elt)
+	}
+
+	return acc
+}
+
+// FoldRight threads each element of the slice, from last to first, through fn along
+// with the accumulator started at initial, and returns the final accumulated value.
+func FoldRight[T, U any](iterable []T, initial U, fn func(T, U) U) U {
+	acc := initial
+	for i := len(iterable) - 1; i >= 0; i-- {
+		acc = fn(iterable[i], acc)
+	}
+
+	return acc
+}
+
+// Sort returns a new slice containing the elements of the iterable in ascending order.
+// The relative order of equal elements is preserved and the original slice is untouched.
+func Sort[T Ordered](iterable []T) []T {
+	result := append([]T(nil), iterable...)
+	slices.SortStableFunc(result, func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result
+}
+
+// SortBy returns a new slice containing the elements of the iterable ordered according
+// to the less function. The relative order of equal elements is preserved and the
+// original slice is untouched.
+func SortBy[T any](iterable []T, less func(a, b T) bool) []T {
+	result := append([]T(nil), iterable...)
+	slices.SortStableFunc(result, func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	return result
+}
+
+// Unique returns a new slice containing only the first occurrence of each element held
+// in the iterable, preserving their original order.
+func Unique[T comparable](iterable []T) []T {
+	return UniqueBy(iterable, func(elt T) T { return elt })
+}
+
+// UniqueBy returns a new slice containing only the first occurrence of each element
+// held in the iterable, according to the key returned by keyFn, preserving their
+// original order.
+func UniqueBy[T any, K comparable](iterable []T, keyFn func(T) K) []T {
+	seen := sets.New[K]()
+	result := make([]T, 0, len(iterable))
+
+	for _, elt := range iterable {
+		key := keyFn(elt)
+		if seen.Contains(key) {
+			continue
+		}
+		seen.Add(key)
+		result = append(result, elt)
+	}
+
+	return result
+}
+
+// Equal reports whether a and b hold the same elements in the same order.
+func Equal[T comparable](a, b []T) bool {
+	return EqualBy(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualBy reports whether a and b hold the same number of elements and whether eq
+// reports true for every pair of elements at the same position.
+func EqualBy[T any](a, b []T, eq func(T, T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,167 @@
+package itertools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMinReturnsSmallestElement(t *testing.T) {
+	slice := []int{5, 2, 8, 1, 9}
+	result, err := Min(slice)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if result != 1 {
+		t.Errorf("Expected 1 but got %d", result)
+	}
+}
+
+func TestMinReturnsErrorOnEmptySlice(t *testing.T) {
+	_, err := Min([]int{})
+
+	if err == nil {
+		t.Error("Expected an error when calling Min on an empty slice")
+	}
+}
+
+func TestMaxReturnsLargestElement(t *testing.T) {
+	slice := []int{5, 2, 8, 1, 9}
+	result, err := Max(slice)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if result != 9 {
+		t.Errorf("Expected 9 but got %d", result)
+	}
+}
+
+func TestMinByUsesProvidedLessFunction(t *testing.T) {
+	slice := []string{"abc", "a", "ab"}
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+
+	result, err := MinBy(slice, byLength)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if result != "a" {
+		t.Errorf("Expected \"a\" but got %q", result)
+	}
+}
+
+func TestMaxByUsesProvidedLessFunction(t *testing.T) {
+	slice := []string{"a", "abc", "ab"}
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+
+	result, err := MaxBy(slice, byLength)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if result != "abc" {
+		t.Errorf("Expected \"abc\" but got %q", result)
+	}
+}
+
+func TestSumAddsUpIntegers(t *testing.T) {
+	slice := []int{1, 2, 3, 4}
+	expected := 10
+	result := Sum(slice)
+
+	if result != expected {
+		t.Errorf("Expected %d but got %d", expected, result)
+	}
+}
+
+func TestProductMultipliesIntegers(t *testing.T) {
+	slice := []int{1, 2, 3, 4}
+	expected := 24
+	result := Product(slice)
+
+	if result != expected {
+		t.Errorf("Expected %d but got %d", expected, result)
+	}
+}
+
+func TestReduceSumsIntegers(t *testing.T) {
+	slice := []int{1, 2, 3}
+	sum := func(acc int, v int) int { return acc + v }
+	expected := 6
+	result := Reduce(slice, 0, sum)
+
+	if result != expected {
+		t.Errorf("Expected %d but got %d", expected, result)
+	}
+}
+
+func TestFoldRightBuildsStringInReverse(t *testing.T) {
+	slice := []string{"a", "b", "c"}
+	concat := func(v string, acc string) string { return v + acc }
+	expected := "abc"
+	result := FoldRight(slice, "", concat)
+
+	if result != expected {
+		t.Errorf("Expected %q but got %q", expected, result)
+	}
+}
+
+func TestSortDoesNotMutateOriginalSlice(t *testing.T) {
+	slice := []int{3, 1, 2}
+	expectedSorted := []int{1, 2, 3}
+	expectedOriginal := []int{3, 1, 2}
+
+	result := Sort(slice)
+
+	if !reflect.DeepEqual(expectedSorted, result) {
+		t.Errorf("Expected %v but got %v", expectedSorted, result)
+	}
+
+	if !reflect.DeepEqual(expectedOriginal, slice) {
+		t.Errorf("Expected Sort to leave the original slice untouched, but got %v", slice)
+	}
+}
+
+func TestSortByOrdersAccordingToLessFunction(t *testing.T) {
+	slice := []string{"abc", "a", "ab"}
+	byLength := func(a, b string) bool { return len(a) < len(b) }
+	expectedSorted := []string{"a", "ab", "abc"}
+	expectedOriginal := []string{"abc", "a", "ab"}
+
+	result := SortBy(slice, byLength)
+
+	if !reflect.DeepEqual(expectedSorted, result) {
+		t.Errorf("Expected %v but got %v", expectedSorted, result)
+	}
+
+	if !reflect.DeepEqual(expectedOriginal, slice) {
+		t.Errorf("Expected SortBy to leave the original slice untouched, but got %v", slice)
+	}
+}
+
+func TestUniquePreservesFirstOccurrenceOrder(t *testing.T) {
+	slice := []int{3, 1, 2, 1, 3}
+	expected := []int{3, 1, 2}
+	result := Unique(slice)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestEqualReportsSameElementsInSameOrder(t *testing.T) {
+	a := []int{1, 2, 3}
+	b := []int{1, 2, 3}
+	c := []int{1, 3, 2}
+
+	if !Equal(a, b) {
+		t.Errorf("Expected %v and %v to be equal", a, b)
+	}
+
+	if Equal(a, c) {
+		t.Errorf("Expected %v and %v not to be equal", a, c)
+	}
+}
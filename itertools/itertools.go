@@ -161,3 +161,94 @@ func GroupBy[TKey comparable, TValue any](iterable []TValue, keyFn func(TValue)
 
 	return result
 }
+
+// SlidingWindow returns overlapping windows of size consecutive elements of the slice,
+// advancing by step elements between windows. It returns an empty result, rather than
+// panicking, when the slice is shorter than size. size and step must both be positive.
+func SlidingWindow[T any](iterable []T, size, step int) ([][]T, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("%d is not a valid window size, you must provide a positive integer", size)
+	}
+
+	if step <= 0 {
+		return nil, fmt.Errorf("%d is not a valid step, you must provide a positive integer", step)
+	}
+
+	n := len(iterable)
+	if n < size {
+		return [][]T{}, nil
+	}
+
+	result := make([][]T, 0)
+	for start := 0; start+size <= n; start += step {
+		window := make([]T, size)
+		copy(window, iterable[start:start+size])
+		result = append(result, window)
+	}
+
+	return result, nil
+}
+
+// Partition splits the slice in a single pass into the elements that satisfy the
+// predicate and the ones that don't.
+func Partition[T any](iterable []T, predicate func(T) bool) (matched, unmatched []T) {
+	matched = make([]T, 0)
+	unmatched = make([]T, 0)
+
+	for _, element := range iterable {
+		if predicate(element) {
+			matched = append(matched, element)
+		} else {
+			unmatched = append(unmatched, element)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// ChunkBy groups runs of consecutive elements that share the same key returned by
+// keyFn. Unlike GroupBy, elements sharing a key that are not adjacent end up in
+// different groups.
+func ChunkBy[T any, K comparable](iterable []T, keyFn func(T) K) [][]T {
+	if len(iterable) == 0 {
+		return [][]T{}
+	}
+
+	result := make([][]T, 0)
+	currentKey := keyFn(iterable[0])
+	current := []T{iterable[0]}
+
+	for _, element := range iterable[1:] {
+		key := keyFn(element)
+		if key == currentKey {
+			current = append(current, element)
+			continue
+		}
+
+		result = append(result, current)
+		current = []T{element}
+		currentKey = key
+	}
+
+	return append(result, current)
+}
+
+// GroupByReduce groups the elements held in the slice by the key returned by keyFn and
+// folds each group into a single accumulated value with reducer, starting from initial.
+// Unlike GroupBy, it never materializes the intermediate []TValue slices, which makes
+// it a better fit for histograms or per-key sums over large inputs.
+func GroupByReduce[TKey comparable, TValue any, TAcc any](iterable []TValue, keyFn func(TValue) TKey, initial TAcc, reducer func(TAcc, TValue) TAcc) map[TKey]TAcc {
+	result := make(map[TKey]TAcc)
+
+	for _, element := range iterable {
+		key := keyFn(element)
+
+		acc, ok := result[key]
+		if !ok {
+			acc = initial
+		}
+		result[key] = reducer(acc, element)
+	}
+
+	return result
+}
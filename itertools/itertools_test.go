@@ -210,3 +210,88 @@ func TestGroupByWithStringOfVaryingLength(t *testing.T) {
 		t.Errorf("An incorrect map has been produced")
 	}
 }
+
+func TestGroupByReduceSumsValuesPerKey(t *testing.T) {
+	input := []int{1, 2, 3, 4, 5, 6}
+	keyFn := func(i int) string {
+		if i%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+	sum := func(acc int, i int) int { return acc + i }
+
+	expected := map[string]int{
+		"even": 12,
+		"odd":  9,
+	}
+
+	output := GroupByReduce(input, keyFn, 0, sum)
+
+	if !reflect.DeepEqual(expected, output) {
+		t.Errorf("Expected %v but got %v", expected, output)
+	}
+}
+
+func TestSlidingWindowProducesOverlappingWindows(t *testing.T) {
+	slice := []int{1, 2, 3, 4}
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}}
+
+	result, err := SlidingWindow(slice, 3, 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestSlidingWindowRejectsNonPositiveStep(t *testing.T) {
+	_, err := SlidingWindow([]int{1, 2, 3}, 2, 0)
+
+	if err == nil {
+		t.Error("Expected an error when calling SlidingWindow with a non-positive step")
+	}
+}
+
+func TestSlidingWindowWithSizeLargerThanSliceReturnsEmptyResult(t *testing.T) {
+	result, err := SlidingWindow([]int{1, 2}, 3, 1)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(result) != 0 {
+		t.Errorf("Expected an empty result but got %v", result)
+	}
+}
+
+func TestPartitionSplitsOnPredicate(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	matched, unmatched := Partition(slice, isEven)
+
+	expectedMatched := []int{2, 4, 6}
+	expectedUnmatched := []int{1, 3, 5}
+
+	if !reflect.DeepEqual(expectedMatched, matched) {
+		t.Errorf("Expected matched %v but got %v", expectedMatched, matched)
+	}
+
+	if !reflect.DeepEqual(expectedUnmatched, unmatched) {
+		t.Errorf("Expected unmatched %v but got %v", expectedUnmatched, unmatched)
+	}
+}
+
+func TestChunkByGroupsConsecutiveRuns(t *testing.T) {
+	slice := []int{1, 1, 2, 2, 2, 1, 3}
+	keyFn := func(i int) int { return i }
+
+	expected := [][]int{{1, 1}, {2, 2, 2}, {1}, {3}}
+	result := ChunkBy(slice, keyFn)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
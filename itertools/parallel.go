@@ -0,0 +1,252 @@
+package itertools
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// Options configures the concurrency behavior of the Par* functions.
+type Options struct {
+	// Workers is the number of goroutines processing the input concurrently.
+	// A value <= 0 falls back to runtime.NumCPU().
+	Workers int
+
+	// PreserveOrder makes the output slice match the order of the input slice.
+	// When false, results are appended in completion order instead.
+	PreserveOrder bool
+
+	// Context, when set, stops dispatching new work once it is done. Work already
+	// handed to a worker runs to completion. A nil Context falls back to
+	// context.Background().
+	Context context.Context
+}
+
+// resolveOptions fills in the zero-value Workers and Context fields of opts with their
+// defaults and keeps PreserveOrder as given. A nil opts resolves to runtime.NumCPU()
+// workers, PreserveOrder set to true and context.Background().
+func resolveOptions(opts *Options) Options {
+	resolved := Options{
+		Workers:       runtime.NumCPU(),
+		PreserveOrder: true,
+		Context:       context.Background(),
+	}
+
+	if opts == nil {
+		return resolved
+	}
+
+	if opts.Workers > 0 {
+		resolved.Workers = opts.Workers
+	}
+	resolved.PreserveOrder = opts.PreserveOrder
+	if opts.Context != nil {
+		resolved.Context = opts.Context
+	}
+
+	return resolved
+}
+
+// ParMap transforms every element within the iterable into a TOut element by applying
+// the provided transformation function across a bounded pool of goroutines.
+func ParMap[TIn, TOut any](iterable []TIn, transformFn func(TIn) TOut, opts *Options) []TOut {
+	results, _ := ParMapErr(iterable, func(item TIn) (TOut, error) {
+		return transformFn(item), nil
+	}, opts)
+
+	return results
+}
+
+// ParMapErr is the error-aware variant of ParMap. It stops dispatching new work and
+// returns the first error encountered as soon as one occurs.
+func ParMapErr[TIn, TOut any](iterable []TIn, transformFn func(TIn) (TOut, error), opts *Options) ([]TOut, error) {
+	resolved := resolveOptions(opts)
+	ctx, cancel := context.WithCancel(resolved.Context)
+	defer cancel()
+
+	results := make([]TOut, 0, len(iterable))
+	if resolved.PreserveOrder {
+		results = make([]TOut, len(iterable))
+	}
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < resolved.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				out, err := transformFn(iterable[idx])
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+					continue
+				}
+
+				if resolved.PreserveOrder {
+					results[idx] = out
+				} else {
+					results = append(results, out)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for idx := range iterable {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- idx:
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return results, nil
+}
+
+// ParFilter removes from the iterable elements that don't satisfy the predicate,
+// evaluating the predicate across a bounded pool of goroutines.
+func ParFilter[T any](iterable []T, predicate func(T) bool, opts *Options) []T {
+	results, _ := ParFilterErr(iterable, func(item T) (bool, error) {
+		return predicate(item), nil
+	}, opts)
+
+	return results
+}
+
+// ParFilterErr is the error-aware variant of ParFilter. It stops dispatching new work
+// and returns the first error encountered as soon as one occurs. When PreserveOrder is
+// true the kept elements come back in input order; otherwise they come back in
+// completion order.
+func ParFilterErr[T any](iterable []T, predicate func(T) (bool, error), opts *Options) ([]T, error) {
+	resolved := resolveOptions(opts)
+	ctx, cancel := context.WithCancel(resolved.Context)
+	defer cancel()
+
+	kept := make([]bool, len(iterable))
+	result := make([]T, 0, len(iterable))
+
+	var (
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < resolved.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				ok, err := predicate(iterable[idx])
+
+				mu.Lock()
+				if err != nil {
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+					continue
+				}
+
+				if resolved.PreserveOrder {
+					kept[idx] = ok
+				} else if ok {
+					result = append(result, iterable[idx])
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for idx := range iterable {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- idx:
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	if !resolved.PreserveOrder {
+		return result, nil
+	}
+
+	for idx, ok := range kept {
+		if ok {
+			result = append(result, iterable[idx])
+		}
+	}
+
+	return result, nil
+}
+
+// ParForEach calls the given function for each element within the iterable, spreading
+// the calls across a bounded pool of goroutines.
+func ParForEach[T any](iterable []T, fn func(T), opts *Options) {
+	resolved := resolveOptions(opts)
+	ctx, cancel := context.WithCancel(resolved.Context)
+	defer cancel()
+
+	jobs := make(chan T)
+	var wg sync.WaitGroup
+
+	for w := 0; w < resolved.Workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for item := range jobs {
+				fn(item)
+			}
+		}()
+	}
+
+dispatch:
+	for _, item := range iterable {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case jobs <- item:
+		}
+	}
+	close(jobs)
+
+	wg.Wait()
+}
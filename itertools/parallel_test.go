@@ -0,0 +1,191 @@
+package itertools
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParMapPreservesOrder(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5}
+	squareFn := func(i int) int { return i * i }
+
+	expected := []int{1, 4, 9, 16, 25}
+	result := ParMap(slice, squareFn, &Options{Workers: 4, PreserveOrder: true})
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestParFilterKeepsEvenIntegers(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5, 6}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	expected := []int{2, 4, 6}
+	result := ParFilter(slice, isEven, &Options{Workers: 3, PreserveOrder: true})
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestParMapErrPropagatesFirstError(t *testing.T) {
+	slice := []int{1, 2, 3}
+	boom := errors.New("boom")
+
+	_, err := ParMapErr(slice, func(i int) (int, error) {
+		if i == 2 {
+			return 0, boom
+		}
+		return i, nil
+	}, &Options{Workers: 2})
+
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected error %v but got %v", boom, err)
+	}
+}
+
+func TestParFilterErrPropagatesFirstError(t *testing.T) {
+	slice := []int{1, 2, 3}
+	boom := errors.New("boom")
+
+	_, err := ParFilterErr(slice, func(i int) (bool, error) {
+		if i == 2 {
+			return false, boom
+		}
+		return true, nil
+	}, &Options{Workers: 2})
+
+	if !errors.Is(err, boom) {
+		t.Errorf("Expected error %v but got %v", boom, err)
+	}
+}
+
+func TestParFilterErrRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var evaluated int32
+	slice := []int{1, 2, 3, 4, 5}
+	_, err := ParFilterErr(slice, func(i int) (bool, error) {
+		atomic.AddInt32(&evaluated, 1)
+		return true, nil
+	}, &Options{Workers: 2, Context: ctx})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if evaluated == int32(len(slice)) {
+		t.Errorf("Expected cancellation to stop dispatching new work, but all %d items were evaluated", len(slice))
+	}
+}
+
+func TestParFilterErrWithoutPreserveOrderKeepsMatchingElements(t *testing.T) {
+	slice := []int{1, 2, 3, 4, 5}
+	isEven := func(i int) (bool, error) { return i%2 == 0, nil }
+
+	result, err := ParFilterErr(slice, isEven, &Options{Workers: 4, PreserveOrder: false})
+	if err != nil {
+		t.Error(err)
+	}
+
+	expected := []int{2, 4}
+	if !Equal(Sort(result), expected) {
+		t.Errorf("Expected the kept elements to be %v regardless of order but got %v", expected, result)
+	}
+}
+
+func TestParForEachRespectsCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var processed int32
+	slice := []int{1, 2, 3, 4, 5}
+	ParForEach(slice, func(i int) {
+		atomic.AddInt32(&processed, 1)
+	}, &Options{Workers: 2, Context: ctx})
+
+	if processed == int32(len(slice)) {
+		t.Errorf("Expected cancellation to stop dispatching new work, but all %d items were processed", len(slice))
+	}
+}
+
+func BenchmarkMapSequential(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+	squareFn := func(i int) int { return i * i }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Map(slice, squareFn)
+	}
+}
+
+func BenchmarkParMap(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+	squareFn := func(i int) int { return i * i }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParMap(slice, squareFn, nil)
+	}
+}
+
+func BenchmarkFilterSequential(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Filter(slice, isEven)
+	}
+}
+
+func BenchmarkParFilter(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParFilter(slice, isEven, nil)
+	}
+}
+
+func BenchmarkForEachSequential(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ForEach(slice, func(int) {})
+	}
+}
+
+func BenchmarkParForEach(b *testing.B) {
+	slice := make([]int, 10_000)
+	for i := range slice {
+		slice[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ParForEach(slice, func(int) {}, nil)
+	}
+}
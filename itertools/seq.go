@@ -0,0 +1,470 @@
+package itertools
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/thiomajid/go-utils/sets"
+)
+
+// FromSlice turns a slice into a Seq that yields its elements in order.
+func FromSlice[T any](items []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap turns a map into a Seq2 that yields its entries. Iteration order follows
+// Go's regular map iteration order and is therefore not guaranteed.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromChannel turns a channel into a Seq that yields the values received from it
+// until the channel is closed or the consumer stops iterating.
+func FromChannel[T any](ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Range produces a Seq of integers starting at start (inclusive) and stopping before
+// stop (exclusive), advancing by step on each iteration. A step of 0 produces an empty
+// sequence instead of looping forever.
+func Range(start, stop, step int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		if step == 0 {
+			return
+		}
+
+		if step > 0 {
+			for i := start; i < stop; i += step {
+				if !yield(i) {
+					return
+				}
+			}
+			return
+		}
+
+		for i := start; i > stop; i += step {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Collect drains a Seq into a new slice, pulling every value it yields.
+func Collect[T any](seq iter.Seq[T]) []T {
+	result := make([]T, 0)
+	for v := range seq {
+		result = append(result, v)
+	}
+
+	return result
+}
+
+// MapSeq lazily transforms each value pulled from seq into a TOut value.
+func MapSeq[TIn any, TOut any](seq iter.Seq[TIn], transformFn func(TIn) TOut) iter.Seq[TOut] {
+	return func(yield func(TOut) bool) {
+		for v := range seq {
+			if !yield(transformFn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq lazily yields only the values pulled from seq that satisfy the predicate.
+func FilterSeq[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if predicate(v) {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// TakeWhileSeq lazily yields values pulled from seq until the first one that doesn't
+// satisfy the predicate, at which point the upstream sequence is no longer pulled.
+func TakeWhileSeq[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !predicate(v) {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SkipWhileSeq lazily discards values pulled from seq while they satisfy the predicate,
+// then yields the remaining values from the first one that doesn't.
+func SkipWhileSeq[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipping := true
+		for v := range seq {
+			if skipping {
+				if predicate(v) {
+					continue
+				}
+				skipping = false
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// ChunkSeq lazily groups values pulled from seq into windows of at most size elements,
+// yielding a shorter final window if the sequence doesn't divide evenly. A non-positive
+// size yields an empty sequence instead of panicking.
+func ChunkSeq[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 {
+			return
+		}
+
+		buf := make([]T, 0, size)
+		for v := range seq {
+			buf = append(buf, v)
+			if len(buf) == size {
+				if !yield(buf) {
+					return
+				}
+				buf = make([]T, 0, size)
+			}
+		}
+
+		if len(buf) > 0 {
+			yield(buf)
+		}
+	}
+}
+
+// FlattenSeq lazily yields every element of every slice pulled from seq, in order.
+func FlattenSeq[T any](seq iter.Seq[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for chunk := range seq {
+			for _, v := range chunk {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// GroupBySeq groups the values pulled from seq by the key returned by keyFn and yields
+// the resulting groups as key/values pairs. It must drain seq entirely before it can
+// yield anything, since an element's group isn't known to be complete until then.
+func GroupBySeq[TKey comparable, TValue any](seq iter.Seq[TValue], keyFn func(TValue) TKey) iter.Seq2[TKey, []TValue] {
+	return func(yield func(TKey, []TValue) bool) {
+		grouped := GroupBy(Collect(seq), keyFn)
+		for k, v := range grouped {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Take lazily yields at most n values pulled from seq, stopping the upstream sequence
+// as soon as the limit is reached.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+
+		count := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			count++
+			if count >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip lazily discards the first n values pulled from seq and yields the rest.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		count := 0
+		for v := range seq {
+			if count < n {
+				count++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Zip lazily pairs up values pulled from a and b, stopping as soon as either sequence
+// is exhausted.
+func Zip[T, U any](a iter.Seq[T], b iter.Seq[U]) iter.Seq2[T, U] {
+	return func(yield func(T, U) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+
+		for {
+			va, okA := nextA()
+			vb, okB := nextB()
+			if !okA || !okB {
+				return
+			}
+			if !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate lazily pairs each value pulled from seq with its zero-based index.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Distinct lazily yields only the first occurrence of each value pulled from seq.
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := sets.New[T]()
+		for v := range seq {
+			if seen.Contains(v) {
+				continue
+			}
+			seen.Add(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Window lazily yields overlapping windows of size consecutive values pulled from seq,
+// advancing by one element between windows. A non-positive size yields an empty sequence.
+func Window[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	return SlidingWindowSeq(seq, size, 1)
+}
+
+// SlidingWindowSeq lazily yields overlapping windows of size consecutive values pulled
+// from seq, advancing by step elements between windows. A non-positive size or step
+// yields an empty sequence instead of panicking.
+func SlidingWindowSeq[T any](seq iter.Seq[T], size, step int) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		if size <= 0 || step <= 0 {
+			return
+		}
+
+		buf := make([]T, 0, size)
+		toSkip := 0
+		for v := range seq {
+			if toSkip > 0 {
+				toSkip--
+				continue
+			}
+
+			buf = append(buf, v)
+			if len(buf) != size {
+				continue
+			}
+
+			window := make([]T, size)
+			copy(window, buf)
+			if !yield(window) {
+				return
+			}
+
+			if step >= size {
+				buf = buf[:0]
+				toSkip = step - size
+			} else {
+				buf = append(buf[:0], buf[step:]...)
+			}
+		}
+	}
+}
+
+// PartitionSeq lazily splits seq into the values that satisfy the predicate and the
+// ones that don't, via Tee, so both returned sequences can be pulled independently
+// without materializing seq up front.
+func PartitionSeq[T any](seq iter.Seq[T], predicate func(T) bool) (matched, unmatched iter.Seq[T]) {
+	teed := Tee(seq, 2)
+	matched = FilterSeq(teed[0], predicate)
+	unmatched = FilterSeq(teed[1], func(v T) bool { return !predicate(v) })
+	return matched, unmatched
+}
+
+// ChunkBySeq lazily groups runs of consecutive values pulled from seq that share the
+// same key returned by keyFn. Unlike GroupBySeq, values sharing a key that are not
+// adjacent end up in different groups.
+func ChunkBySeq[T any, K comparable](seq iter.Seq[T], keyFn func(T) K) iter.Seq[[]T] {
+	return func(yield func([]T) bool) {
+		var (
+			current    []T
+			currentKey K
+			started    bool
+		)
+
+		for v := range seq {
+			key := keyFn(v)
+			if !started {
+				current = []T{v}
+				currentKey = key
+				started = true
+				continue
+			}
+
+			if key == currentKey {
+				current = append(current, v)
+				continue
+			}
+
+			if !yield(current) {
+				return
+			}
+			current = []T{v}
+			currentKey = key
+		}
+
+		if started {
+			yield(current)
+		}
+	}
+}
+
+// Tee splits seq into n independently consumable sequences that each yield the same
+// values in the same order. seq is pulled lazily through a single shared buffer: a
+// value is only fetched from seq once, and is evicted from the buffer as soon as every
+// branch has read past it, so Tee is safe to use on huge or infinite sources as long
+// as the branches are driven at a roughly similar pace. The buffer has no size limit:
+// it holds every value between the slowest and the fastest branch. Branches may be
+// consumed from different goroutines. seq is released once it is exhausted; a branch
+// that stops iterating early does not release it, and the values that branch has not
+// read stay in the buffer.
+func Tee[T any](seq iter.Seq[T], n int) []iter.Seq[T] {
+	if n <= 0 {
+		return []iter.Seq[T]{}
+	}
+
+	shared := &teeState[T]{positions: make([]int, n)}
+	shared.next, shared.stop = iter.Pull(seq)
+
+	seqs := make([]iter.Seq[T], n)
+	for i := 0; i < n; i++ {
+		seqs[i] = shared.branch(i)
+	}
+
+	return seqs
+}
+
+// teeState holds the single upstream pull-iterator and buffer shared by every branch
+// returned from Tee.
+type teeState[T any] struct {
+	mu        sync.Mutex
+	buf       []T
+	base      int
+	done      bool
+	next      func() (T, bool)
+	stop      func()
+	positions []int
+}
+
+// branch returns the Seq fed to the branch-th caller of Tee.
+func (s *teeState[T]) branch(branch int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			s.mu.Lock()
+			pos := s.positions[branch]
+			for pos-s.base >= len(s.buf) && !s.done {
+				v, ok := s.next()
+				if !ok {
+					s.done = true
+					s.stop()
+					break
+				}
+				s.buf = append(s.buf, v)
+			}
+
+			if pos-s.base >= len(s.buf) {
+				s.mu.Unlock()
+				return
+			}
+
+			v := s.buf[pos-s.base]
+			s.positions[branch] = pos + 1
+			s.evictConsumed()
+			s.mu.Unlock()
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// evictConsumed drops the leading buffered values that every branch has already read
+// past. Callers must hold s.mu.
+func (s *teeState[T]) evictConsumed() {
+	min := s.positions[0]
+	for _, pos := range s.positions[1:] {
+		if pos < min {
+			min = pos
+		}
+	}
+
+	if min <= s.base {
+		return
+	}
+
+	drop := min - s.base
+	s.buf = s.buf[drop:]
+	s.base = min
+}
+
+// ReduceSeq drains seq, threading each value through fn along with the accumulator
+// started at initial, and returns the final accumulated value.
+func ReduceSeq[T, U any](seq iter.Seq[T], initial U, fn func(U, T) U) U {
+	acc := initial
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
@@ -0,0 +1,303 @@
+package itertools
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCollectFromSlice(t *testing.T) {
+	slice := []int{1, 2, 3}
+	result := Collect(FromSlice(slice))
+
+	if !reflect.DeepEqual(slice, result) {
+		t.Errorf("Expected %v but got %v", slice, result)
+	}
+}
+
+func TestRangeWithPositiveStep(t *testing.T) {
+	expected := []int{0, 2, 4}
+	result := Collect(Range(0, 6, 2))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestRangeWithNegativeStep(t *testing.T) {
+	expected := []int{5, 3, 1}
+	result := Collect(Range(5, 0, -2))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestMapSeqBySquaringIntegers(t *testing.T) {
+	squareFn := func(i int) int { return i * i }
+	expected := []int{1, 4, 9}
+	result := Collect(MapSeq(FromSlice([]int{1, 2, 3}), squareFn))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestFilterSeqToKeepEvenIntegers(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	expected := []int{2, 4}
+	result := Collect(FilterSeq(FromSlice([]int{1, 2, 3, 4, 5}), isEven))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestFromMapYieldsEveryEntry(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	collected := make(map[string]int, len(m))
+	for k, v := range FromMap(m) {
+		collected[k] = v
+	}
+
+	if !reflect.DeepEqual(m, collected) {
+		t.Errorf("Expected %v but got %v", m, collected)
+	}
+}
+
+func TestFromChannelYieldsUntilClosed(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+
+	expected := []int{1, 2, 3}
+	result := Collect(FromChannel(ch))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestTakeWhileSeqStopsAtFirstMismatch(t *testing.T) {
+	predicate := func(s string) bool { return len(s) == 3 }
+	expected := []string{"foo", "bar"}
+	result := Collect(TakeWhileSeq(FromSlice([]string{"foo", "bar", "z", "baz"}), predicate))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestSkipWhileSeqSkipsLeadingMatches(t *testing.T) {
+	predicate := func(s string) bool { return len(s) == 3 }
+	expected := []string{"z", "45"}
+	result := Collect(SkipWhileSeq(FromSlice([]string{"foo", "bar", "z", "45"}), predicate))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestChunkSeqYieldsShorterFinalWindow(t *testing.T) {
+	expected := [][]int{{1, 2, 3}, {4, 5}}
+	var result [][]int
+	for chunk := range ChunkSeq(FromSlice([]int{1, 2, 3, 4, 5}), 3) {
+		result = append(result, chunk)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestFlattenSeqYieldsEveryElementInOrder(t *testing.T) {
+	expected := []int{1, 2, 3, 4, 5, 6}
+	result := Collect(FlattenSeq(FromSlice([][]int{{1, 2, 3}, {}, {4, 5, 6}})))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestGroupBySeqGroupsByDerivedKey(t *testing.T) {
+	keyFn := func(s string) int { return len(s) }
+	expected := map[int][]string{
+		1: {"a", "b"},
+		2: {"aa"},
+		3: {"bbb"},
+	}
+
+	grouped := make(map[int][]string)
+	for key, values := range GroupBySeq(FromSlice([]string{"a", "aa", "b", "bbb"}), keyFn) {
+		sort.Strings(values)
+		grouped[key] = values
+	}
+
+	if !reflect.DeepEqual(expected, grouped) {
+		t.Errorf("Expected %v but got %v", expected, grouped)
+	}
+}
+
+func TestTakeStopsUpstreamAtLimit(t *testing.T) {
+	pulled := 0
+	source := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	expected := []int{0, 1, 2}
+	result := Collect(Take(source, 3))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+
+	if pulled != 3 {
+		t.Errorf("Expected the upstream sequence to be pulled 3 times but it was pulled %d times", pulled)
+	}
+}
+
+func TestSkipDiscardsLeadingValues(t *testing.T) {
+	expected := []int{3, 4, 5}
+	result := Collect(Skip(FromSlice([]int{1, 2, 3, 4, 5}), 2))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestZipStopsAtShorterSequence(t *testing.T) {
+	a := FromSlice([]int{1, 2, 3})
+	b := FromSlice([]string{"a", "b"})
+
+	var keys []int
+	var values []string
+	for k, v := range Zip(a, b) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(keys, []int{1, 2}) || !reflect.DeepEqual(values, []string{"a", "b"}) {
+		t.Errorf("Expected Zip to stop at the shorter sequence, got keys=%v values=%v", keys, values)
+	}
+}
+
+func TestEnumerateYieldsIndexValuePairs(t *testing.T) {
+	var indexes []int
+	var values []string
+	for i, v := range Enumerate(FromSlice([]string{"a", "b", "c"})) {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+
+	if !reflect.DeepEqual(indexes, []int{0, 1, 2}) || !reflect.DeepEqual(values, []string{"a", "b", "c"}) {
+		t.Errorf("Expected enumerated pairs to match, got indexes=%v values=%v", indexes, values)
+	}
+}
+
+func TestDistinctRemovesDuplicates(t *testing.T) {
+	expected := []int{1, 2, 3}
+	result := Collect(Distinct(FromSlice([]int{1, 2, 2, 3, 1, 3})))
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestWindowYieldsOverlappingSlices(t *testing.T) {
+	expected := [][]int{{1, 2, 3}, {2, 3, 4}}
+	var result [][]int
+	for w := range Window(FromSlice([]int{1, 2, 3, 4}), 3) {
+		result = append(result, w)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestTeeProducesIndependentSequences(t *testing.T) {
+	teed := Tee(FromSlice([]int{1, 2, 3}), 2)
+
+	if len(teed) != 2 {
+		t.Fatalf("Expected 2 sequences but got %d", len(teed))
+	}
+
+	for _, seq := range teed {
+		expected := []int{1, 2, 3}
+		result := Collect(seq)
+		if !reflect.DeepEqual(expected, result) {
+			t.Errorf("Expected %v but got %v", expected, result)
+		}
+	}
+}
+
+func TestSlidingWindowSeqWithStepGreaterThanOne(t *testing.T) {
+	expected := [][]int{{1, 2, 3}, {3, 4, 5}}
+	var result [][]int
+	for w := range SlidingWindowSeq(FromSlice([]int{1, 2, 3, 4, 5, 6}), 3, 2) {
+		result = append(result, w)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestSlidingWindowSeqWithStepGreaterThanSize(t *testing.T) {
+	expected := [][]int{{1, 2}, {6, 7}}
+	var result [][]int
+	for w := range SlidingWindowSeq(FromSlice([]int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}), 2, 5) {
+		result = append(result, w)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestChunkBySeqGroupsConsecutiveRuns(t *testing.T) {
+	keyFn := func(i int) int { return i }
+	expected := [][]int{{1, 1}, {2}, {1}}
+	var result [][]int
+	for group := range ChunkBySeq(FromSlice([]int{1, 1, 2, 1}), keyFn) {
+		result = append(result, group)
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestPartitionSeqSplitsOnPredicate(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+	matched, unmatched := PartitionSeq(FromSlice([]int{1, 2, 3, 4, 5}), isEven)
+
+	expectedMatched := []int{2, 4}
+	expectedUnmatched := []int{1, 3, 5}
+
+	if !reflect.DeepEqual(expectedMatched, Collect(matched)) {
+		t.Errorf("Expected matched %v but got %v", expectedMatched, Collect(matched))
+	}
+
+	if !reflect.DeepEqual(expectedUnmatched, Collect(unmatched)) {
+		t.Errorf("Expected unmatched %v but got %v", expectedUnmatched, Collect(unmatched))
+	}
+}
+
+func TestReduceSeqSumsIntegers(t *testing.T) {
+	sum := func(acc int, v int) int { return acc + v }
+	expected := 6
+	result := ReduceSeq(FromSlice([]int{1, 2, 3}), 0, sum)
+
+	if result != expected {
+		t.Errorf("Expected %d but got %d", expected, result)
+	}
+}
@@ -0,0 +1,157 @@
+// Defines a set of common functions that can be applied on maps
+package maptools
+
+// Entry holds a single key/value pair extracted from a map.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// Keys returns a new slice holding every key of the map. The order of the returned
+// keys is not guaranteed.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+// Values returns a new slice holding every value of the map. The order of the returned
+// values is not guaranteed.
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// Entries returns a new slice holding every key/value pair of the map. The order of
+// the returned entries is not guaranteed.
+func Entries[K comparable, V any](m map[K]V) []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Entry[K, V]{Key: k, Value: v})
+	}
+
+	return entries
+}
+
+// FromEntries builds a new map from a slice of key/value pairs. When two entries share
+// the same key, the last one wins.
+func FromEntries[K comparable, V any](entries []Entry[K, V]) map[K]V {
+	result := make(map[K]V, len(entries))
+	for _, entry := range entries {
+		result[entry.Key] = entry.Value
+	}
+
+	return result
+}
+
+// Invert returns a new map where every value of m becomes a key and every key becomes
+// its value. When two keys of m share the same value, the one encountered last wins.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+
+	return result
+}
+
+// MergeWith combines m1 and m2 into a new map. When a key is present in both, conflict
+// is called with the key and both values to decide which value is kept.
+func MergeWith[K comparable, V any](m1, m2 map[K]V, conflict func(key K, v1, v2 V) V) map[K]V {
+	result := make(map[K]V, len(m1)+len(m2))
+	for k, v := range m1 {
+		result[k] = v
+	}
+
+	for k, v2 := range m2 {
+		if v1, ok := result[k]; ok {
+			result[k] = conflict(k, v1, v2)
+		} else {
+			result[k] = v2
+		}
+	}
+
+	return result
+}
+
+// MapKeys returns a new map obtained by applying fn to every key of m, keeping the
+// original values. When two keys map to the same new key, the one encountered last wins.
+func MapKeys[K comparable, V any, K2 comparable](m map[K]V, fn func(K) K2) map[K2]V {
+	result := make(map[K2]V, len(m))
+	for k, v := range m {
+		result[fn(k)] = v
+	}
+
+	return result
+}
+
+// MapValues returns a new map obtained by applying fn to every value of m, keeping the
+// original keys.
+func MapValues[K comparable, V any, V2 any](m map[K]V, fn func(V) V2) map[K]V2 {
+	result := make(map[K]V2, len(m))
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+
+	return result
+}
+
+// FilterMap returns a new map holding only the entries of m that satisfy the predicate.
+func FilterMap[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// PickKeys returns a new map holding only the entries of m whose key is in keys.
+func PickKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// OmitKeys returns a new map holding every entry of m whose key is not in keys.
+func OmitKeys[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	excluded := make(map[K]struct{}, len(keys))
+	for _, k := range keys {
+		excluded[k] = struct{}{}
+	}
+
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		if _, ok := excluded[k]; !ok {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// GroupByMap groups the entries of m by the key returned by keyFn and returns a new map
+// from that key to the values of the entries sharing it.
+func GroupByMap[K comparable, V any, GK comparable](m map[K]V, keyFn func(K, V) GK) map[GK][]V {
+	result := make(map[GK][]V)
+	for k, v := range m {
+		group := keyFn(k, v)
+		result[group] = append(result[group], v)
+	}
+
+	return result
+}
@@ -0,0 +1,263 @@
+package maptools
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestKeysReturnsEveryKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	expected := []string{"a", "b", "c"}
+
+	keys := Keys(m)
+	sort.Strings(keys)
+
+	if !reflect.DeepEqual(expected, keys) {
+		t.Errorf("Expected %v but got %v", expected, keys)
+	}
+}
+
+func TestValuesReturnsEveryValue(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	expected := []int{1, 2, 3}
+
+	values := Values(m)
+	sort.Ints(values)
+
+	if !reflect.DeepEqual(expected, values) {
+		t.Errorf("Expected %v but got %v", expected, values)
+	}
+}
+
+func TestEntriesAndFromEntriesRoundTrip(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	entries := Entries(m)
+	roundTripped := FromEntries(entries)
+
+	if !reflect.DeepEqual(m, roundTripped) {
+		t.Errorf("Expected %v but got %v after a round trip through Entries/FromEntries", m, roundTripped)
+	}
+}
+
+func TestInvertSwapsKeysAndValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	expected := map[int]string{1: "a", 2: "b"}
+
+	result := Invert(m)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestMergeWithResolvesConflictingKeys(t *testing.T) {
+	m1 := map[string]int{"a": 1, "b": 2}
+	m2 := map[string]int{"b": 20, "c": 3}
+	sumConflict := func(key string, v1, v2 int) int { return v1 + v2 }
+
+	expected := map[string]int{"a": 1, "b": 22, "c": 3}
+	result := MergeWith(m1, m2, sumConflict)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestMapValuesDoublesIntegers(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	double := func(v int) int { return v * 2 }
+
+	expected := map[string]int{"a": 2, "b": 4}
+	result := MapValues(m, double)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestFilterMapKeepsMatchingEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	isEven := func(k string, v int) bool { return v%2 == 0 }
+
+	expected := map[string]int{"b": 2}
+	result := FilterMap(m, isEven)
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestPickKeysKeepsOnlyRequestedKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	expected := map[string]int{"a": 1, "c": 3}
+	result := PickKeys(m, "a", "c")
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestOmitKeysRemovesRequestedKeys(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	expected := map[string]int{"a": 1, "c": 3}
+	result := OmitKeys(m, "b")
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestGroupByMapGroupsValuesByDerivedKey(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+	parity := func(k string, v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	result := GroupByMap(m, parity)
+	for _, group := range result {
+		sort.Ints(group)
+	}
+
+	expected := map[string][]int{
+		"even": {2, 4},
+		"odd":  {1, 3},
+	}
+
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func benchmarkMap(size int) map[int]int {
+	m := make(map[int]int, size)
+	for i := 0; i < size; i++ {
+		m[i] = i
+	}
+
+	return m
+}
+
+func BenchmarkKeys(b *testing.B) {
+	m := benchmarkMap(10_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Keys(m)
+	}
+}
+
+func BenchmarkValues(b *testing.B) {
+	m := benchmarkMap(10_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Values(m)
+	}
+}
+
+func BenchmarkEntries(b *testing.B) {
+	m := benchmarkMap(10_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Entries(m)
+	}
+}
+
+func BenchmarkFromEntries(b *testing.B) {
+	entries := Entries(benchmarkMap(10_000))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FromEntries(entries)
+	}
+}
+
+func BenchmarkInvert(b *testing.B) {
+	m := benchmarkMap(10_000)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Invert(m)
+	}
+}
+
+func BenchmarkMapKeys(b *testing.B) {
+	m := benchmarkMap(10_000)
+	double := func(k int) int { return k * 2 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MapKeys(m, double)
+	}
+}
+
+func BenchmarkMapValues(b *testing.B) {
+	m := benchmarkMap(10_000)
+	double := func(v int) int { return v * 2 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MapValues(m, double)
+	}
+}
+
+func BenchmarkFilterMap(b *testing.B) {
+	m := benchmarkMap(10_000)
+	isEven := func(k, v int) bool { return v%2 == 0 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		FilterMap(m, isEven)
+	}
+}
+
+func BenchmarkPickKeys(b *testing.B) {
+	m := benchmarkMap(10_000)
+	keys := Keys(m)[:100]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		PickKeys(m, keys...)
+	}
+}
+
+func BenchmarkOmitKeys(b *testing.B) {
+	m := benchmarkMap(10_000)
+	keys := Keys(m)[:100]
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		OmitKeys(m, keys...)
+	}
+}
+
+func BenchmarkMergeWith(b *testing.B) {
+	m1 := benchmarkMap(5_000)
+	m2 := benchmarkMap(5_000)
+	sumConflict := func(key, v1, v2 int) int { return v1 + v2 }
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MergeWith(m1, m2, sumConflict)
+	}
+}
+
+func BenchmarkGroupByMap(b *testing.B) {
+	m := benchmarkMap(10_000)
+	parity := func(k, v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		GroupByMap(m, parity)
+	}
+}
@@ -0,0 +1,162 @@
+// Defines a generic Set type and the algebraic operations commonly performed on it
+package sets
+
+import (
+	"encoding/json"
+	"iter"
+)
+
+// Set is an unordered collection of distinct comparable values, backed by a map.
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// New creates a Set containing the given items, discarding duplicates.
+func New[T comparable](items ...T) *Set[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a Set containing the elements of the given slice, discarding
+// duplicates.
+func FromSlice[T comparable](items []T) *Set[T] {
+	s := &Set[T]{items: make(map[T]struct{}, len(items))}
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+
+	return s
+}
+
+// Add inserts item into the set. It is a no-op if the item is already present.
+func (s *Set[T]) Add(item T) {
+	s.items[item] = struct{}{}
+}
+
+// Remove deletes item from the set. It is a no-op if the item isn't present.
+func (s *Set[T]) Remove(item T) {
+	delete(s.items, item)
+}
+
+// Contains reports whether item is present in the set.
+func (s *Set[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Len returns the number of elements held in the set.
+func (s *Set[T]) Len() int {
+	return len(s.items)
+}
+
+// ToSlice returns a new slice holding every element of the set. The order of the
+// returned elements is not guaranteed.
+func (s *Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s.items))
+	for item := range s.items {
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// Clone returns a new set holding a copy of every element of s.
+func (s *Set[T]) Clone() *Set[T] {
+	return FromSlice(s.ToSlice())
+}
+
+// All returns an iter.Seq that yields every element of the set. Iteration order is
+// not guaranteed.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Union returns a new set holding every element present in s or other.
+func (s *Set[T]) Union(other *Set[T]) *Set[T] {
+	result := s.Clone()
+	for item := range other.items {
+		result.Add(item)
+	}
+
+	return result
+}
+
+// Intersection returns a new set holding only the elements present in both s and other.
+func (s *Set[T]) Intersection(other *Set[T]) *Set[T] {
+	result := New[T]()
+	for item := range s.items {
+		if other.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new set holding the elements present in s but not in other.
+func (s *Set[T]) Difference(other *Set[T]) *Set[T] {
+	result := New[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			result.Add(item)
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns a new set holding the elements present in exactly one
+// of s and other.
+func (s *Set[T]) SymmetricDifference(other *Set[T]) *Set[T] {
+	return s.Difference(other).Union(other.Difference(s))
+}
+
+// IsSubsetOf reports whether every element of s is also present in other.
+func (s *Set[T]) IsSubsetOf(other *Set[T]) bool {
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSupersetOf reports whether every element of other is also present in s.
+func (s *Set[T]) IsSupersetOf(other *Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// Equal reports whether s and other hold exactly the same elements.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+
+	return s.IsSubsetOf(other)
+}
+
+// MarshalJSON encodes the set as a JSON array of its elements.
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its current contents.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	s.items = make(map[T]struct{}, len(items))
+	for _, item := range items {
+		s.items[item] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package sets
+
+import (
+	"encoding/json"
+	"sort"
+	"testing"
+)
+
+func TestNewDiscardsDuplicates(t *testing.T) {
+	s := New(1, 2, 2, 3, 1)
+
+	if s.Len() != 3 {
+		t.Errorf("Expected 3 elements but got %d", s.Len())
+	}
+}
+
+func TestContainsReportsMembership(t *testing.T) {
+	s := New("a", "b")
+
+	if !s.Contains("a") {
+		t.Error("Expected the set to contain \"a\"")
+	}
+
+	if s.Contains("z") {
+		t.Error("Expected the set not to contain \"z\"")
+	}
+}
+
+func TestAddAndRemove(t *testing.T) {
+	s := New[int]()
+	s.Add(1)
+	s.Add(2)
+
+	if s.Len() != 2 {
+		t.Errorf("Expected 2 elements but got %d", s.Len())
+	}
+
+	s.Remove(1)
+	if s.Contains(1) {
+		t.Error("Expected 1 to have been removed")
+	}
+}
+
+func TestUnion(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(3, 4, 5)
+
+	result := a.Union(b).ToSlice()
+	sort.Ints(result)
+
+	expected := []int{1, 2, 3, 4, 5}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %v but got %v", expected, result)
+	}
+	for i, v := range expected {
+		if result[i] != v {
+			t.Errorf("Expected %v but got %v", expected, result)
+			break
+		}
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	result := a.Intersection(b).ToSlice()
+	sort.Ints(result)
+
+	expected := []int{2, 3}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %v but got %v", expected, result)
+	}
+	for i, v := range expected {
+		if result[i] != v {
+			t.Errorf("Expected %v but got %v", expected, result)
+			break
+		}
+	}
+}
+
+func TestDifference(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	result := a.Difference(b).ToSlice()
+	expected := []int{1}
+
+	if len(result) != 1 || result[0] != expected[0] {
+		t.Errorf("Expected %v but got %v", expected, result)
+	}
+}
+
+func TestSymmetricDifference(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(2, 3, 4)
+
+	result := a.SymmetricDifference(b).ToSlice()
+	sort.Ints(result)
+
+	expected := []int{1, 4}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %v but got %v", expected, result)
+	}
+	for i, v := range expected {
+		if result[i] != v {
+			t.Errorf("Expected %v but got %v", expected, result)
+			break
+		}
+	}
+}
+
+func TestIsSubsetOfAndIsSupersetOf(t *testing.T) {
+	a := New(1, 2)
+	b := New(1, 2, 3)
+
+	if !a.IsSubsetOf(b) {
+		t.Error("Expected a to be a subset of b")
+	}
+
+	if !b.IsSupersetOf(a) {
+		t.Error("Expected b to be a superset of a")
+	}
+
+	if b.IsSubsetOf(a) {
+		t.Error("Expected b not to be a subset of a")
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := New(1, 2, 3)
+	b := New(3, 2, 1)
+	c := New(1, 2)
+
+	if !a.Equal(b) {
+		t.Error("Expected a and b to be equal regardless of insertion order")
+	}
+
+	if a.Equal(c) {
+		t.Error("Expected a and c not to be equal")
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	s := New(1, 2, 3)
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded Set[int]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !s.Equal(&decoded) {
+		t.Errorf("Expected the decoded set to equal the original, got %v", decoded.ToSlice())
+	}
+}